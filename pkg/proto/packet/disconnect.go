@@ -8,7 +8,6 @@ import (
 	util2 "go.minekube.com/gate/pkg/util"
 	"go.uber.org/zap"
 	"io"
-	"strings"
 )
 
 type Disconnect struct {
@@ -42,12 +41,11 @@ func DisconnectWithProtocol(reason component.Component, protocol proto.Protocol)
 	if reason == nil {
 		reason = &component.Text{} // empty reason
 	}
-	b := new(strings.Builder)
-	if err := util2.JsonCodec(protocol).Marshal(b, reason); err != nil {
+	s, err := util2.DefaultComponentCache.Get(reason, protocol)
+	if err != nil {
 		zap.L().Debug("Error marshal disconnect reason component to json",
 			zap.Error(err))
-		b.Reset() // empty reason
+		s = "" // empty reason
 	}
-	s := b.String()
 	return &Disconnect{Reason: &s}
 }
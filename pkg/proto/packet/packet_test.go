@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"github.com/stretchr/testify/assert"
 	"go.minekube.com/gate/pkg/proto"
+	"go.minekube.com/gate/pkg/proto/util"
 	"reflect"
 	"testing"
 )
@@ -45,3 +46,24 @@ func TestPackets(t *testing.T) {
 		&StatusPing{RandomId: 1234567890},
 	)
 }
+
+func TestTitleEncode(t *testing.T) {
+	s := `{"text":"title"}`
+	expected := new(bytes.Buffer)
+	assert.NoError(t, util.WriteVarInt(expected, int(SetTitle)))
+	assert.NoError(t, util.WriteString(expected, s))
+
+	for _, protocol := range []proto.Protocol{
+		proto.Minecraft_1_8.Protocol,
+		proto.Minecraft_1_12_2.Protocol,
+	} {
+		buf := new(bytes.Buffer)
+		c := &proto.PacketContext{Direction: proto.ClientBound, Protocol: protocol}
+		assert.NoError(t, (&Title{Action: SetTitle, Component: &s}).Encode(c, buf))
+		assert.Equal(t, expected.Bytes(), buf.Bytes(), protocol.String())
+
+		buf.Reset()
+		assert.NoError(t, NewResetTitle(protocol).Encode(c, buf))
+		assert.Equal(t, []byte{byte(ResetTitleAction(protocol))}, buf.Bytes(), protocol.String())
+	}
+}
@@ -42,8 +42,8 @@ func (t *Title) Encode(c *proto.PacketContext, wr io.Writer) error {
 	if c.Protocol.GreaterEqual(proto.Minecraft_1_11) {
 		// 1.11+ shifted the action enum by 1 to handle the action bar
 		switch t.Action {
-		case SetTitle, SetSubtitle, Hide, Reset:
-		case SetActionBar:
+		case Hide, Reset:
+		case SetTitle, SetSubtitle, SetActionBar:
 			if t.Component == nil {
 				return fmt.Errorf("no component found for action %d", t.Action)
 			}
@@ -69,8 +69,8 @@ func (t *Title) Encode(c *proto.PacketContext, wr io.Writer) error {
 		}
 	} else {
 		switch t.Action {
-		case SetTitle, HideOld, ResetOld:
-		case SetSubtitle:
+		case HideOld, ResetOld:
+		case SetTitle, SetSubtitle:
 			if t.Component == nil {
 				return fmt.Errorf("no component found for action %d", t.Action)
 			}
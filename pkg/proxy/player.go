@@ -61,6 +61,15 @@ type Player interface {
 	// SHA-1 hash of the resource pack file. To monitor the status of the sent resource pack,
 	// subscribe to PlayerResourcePackStatusEvent.
 	SendResourcePackWithHash(url string, sha1Hash []byte) error
+	// Sends a title and subtitle (may be nil) to the player.
+	// The title is cleared as soon as ctx is canceled.
+	WriteTitleWithContext(ctx context.Context, title, subtitle component.Component, fadeIn, stay, fadeOut time.Duration) error
+	// Updates the player's action bar with the component returned by fn
+	// every interval until ctx is canceled or the player disconnects.
+	WriteActionBarLoop(ctx context.Context, interval time.Duration, fn func() component.Component) error
+	// Sends a title to the player that is owned by the proxy.
+	// A persistent title is sent again after the player switched servers.
+	SendTitle(title *Title) error
 	// TODO TabList() and more
 }
 
@@ -95,6 +104,8 @@ type connectedPlayer struct {
 
 	serversToTry []string // names of servers to try if we got disconnected from previous
 	tryIndex     int
+
+	title titleManager // The title sent by the proxy.
 }
 
 var _ Player = (*connectedPlayer)(nil)
@@ -223,10 +234,10 @@ func (p *connectedPlayer) SendMessagePosition(msg component.Component, position
 	b := new(strings.Builder)
 	if position == packet.ActionBarMessage {
 		if p.Protocol().GreaterEqual(proto.Minecraft_1_11) {
-			if err = util.JsonCodec(p.Protocol()).Marshal(b, msg); err != nil {
+			s, err := util.DefaultComponentCache.Get(msg, p.Protocol())
+			if err != nil {
 				return err
 			}
-			s := b.String()
 			// We can use the title packet instead
 			return p.WritePacket(&packet.Title{
 				Action:    packet.SetActionBar,
@@ -246,10 +257,9 @@ func (p *connectedPlayer) SendMessagePosition(msg component.Component, position
 		}
 		messageJson = string(j)
 	} else {
-		if err = util.JsonCodec(p.Protocol()).Marshal(b, msg); err != nil {
+		if messageJson, err = util.DefaultComponentCache.Get(msg, p.Protocol()); err != nil {
 			return err
 		}
-		messageJson = b.String()
 	}
 
 	return p.WritePacket(&packet.Chat{
@@ -266,7 +276,7 @@ func (p *connectedPlayer) SendPluginMessage(identifier message.ChannelIdentifier
 	})
 }
 
-// TODO add header/footer, action bar, title & boss bar methods
+// TODO add header/footer & boss bar methods
 
 // Finds another server to attempt to log into, if we were unexpectedly disconnected from the server.
 // current is the current server of the player is on, so we skip this server and not connect to it.
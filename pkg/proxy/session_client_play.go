@@ -286,12 +286,18 @@ func (c *clientPlaySessionHandler) handleBackendJoinGame(joinGame *packet.JoinGa
 		}
 	}
 
-	// Clear any title from the previous server.
+	// Clear any title from the previous server and the proxy.
 	if playerVersion.GreaterEqual(proto.Minecraft_1_8) {
 		resetTitle := packet.NewResetTitle(playerVersion)
 		if c.player.BufferPacket(resetTitle) != nil {
 			return false
 		}
+		// Show the proxy's title again if persistent.
+		if title := c.player.title.switched(); title != nil {
+			if c.player.bufferTitle(title) != nil {
+				return false
+			}
+		}
 	}
 
 	// Flush everything
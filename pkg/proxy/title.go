@@ -0,0 +1,180 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/gate/pkg/proto"
+	"go.minekube.com/gate/pkg/proto/packet"
+	"go.minekube.com/gate/pkg/util"
+	"sync"
+	"time"
+)
+
+// Title is a title shown to a player.
+type Title struct {
+	Title    component.Component // The title, if nil an empty title is shown.
+	Subtitle component.Component // The subtitle, may be nil.
+
+	FadeIn, Stay, FadeOut time.Duration // Title times
+
+	persistent bool
+}
+
+// SetPersistent sets whether the title should be
+// sent again after the player switched servers.
+func (t *Title) SetPersistent(persistent bool) {
+	t.persistent = persistent
+}
+
+// Persistent returns true if the title is sent
+// again after the player switched servers.
+func (t *Title) Persistent() bool {
+	return t.persistent
+}
+
+// titleManager tracks the title a player was sent by the proxy.
+type titleManager struct {
+	mu      sync.Mutex // Protects following field
+	current *Title
+}
+
+func (m *titleManager) set(t *Title) {
+	m.mu.Lock()
+	m.current = t
+	m.mu.Unlock()
+}
+
+// switched is called when the player switched servers and
+// returns the current title if persistent, otherwise it is cleared.
+func (m *titleManager) switched() *Title {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.current != nil && !m.current.persistent {
+		m.current = nil
+	}
+	return m.current
+}
+
+func (p *connectedPlayer) SendTitle(title *Title) error {
+	if title == nil {
+		return errors.New("title must not be nil")
+	}
+	t := *title // copy so the caller can't modify it later
+	p.title.set(&t)
+	if err := p.bufferTitle(&t); err != nil {
+		return err
+	}
+	return p.flush()
+}
+
+func (p *connectedPlayer) WriteTitleWithContext(
+	ctx context.Context,
+	title, subtitle component.Component,
+	fadeIn, stay, fadeOut time.Duration,
+) error {
+	protocol := p.Protocol()
+	if protocol.Lower(proto.Minecraft_1_8) {
+		return nil // Titles were added in 1.8
+	}
+	if err := p.bufferTitle(&Title{
+		Title:    title,
+		Subtitle: subtitle,
+		FadeIn:   fadeIn,
+		Stay:     stay,
+		FadeOut:  fadeOut,
+	}); err != nil {
+		return err
+	}
+	if err := p.flush(); err != nil {
+		return err
+	}
+
+	ctx, cancel := p.newContext(ctx)
+	go func() {
+		defer cancel()
+		<-ctx.Done()
+		if p.Active() {
+			_ = p.WritePacket(packet.NewResetTitle(protocol))
+		}
+	}()
+	return nil
+}
+
+// ErrInvalidInterval is returned if a non-positive interval was passed.
+var ErrInvalidInterval = errors.New("interval must be positive")
+
+func (p *connectedPlayer) WriteActionBarLoop(
+	ctx context.Context,
+	interval time.Duration,
+	fn func() component.Component,
+) error {
+	if interval <= 0 {
+		return ErrInvalidInterval
+	}
+	if err := p.SendMessagePosition(fn(), packet.ActionBarMessage); err != nil {
+		return err
+	}
+
+	ctx, cancel := p.newContext(ctx)
+	go func() {
+		defer cancel()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if p.SendMessagePosition(fn(), packet.ActionBarMessage) != nil {
+					return
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// bufferTitle buffers the packets to show the title to the player.
+func (p *connectedPlayer) bufferTitle(t *Title) error {
+	protocol := p.Protocol()
+	if protocol.Lower(proto.Minecraft_1_8) {
+		return nil // Titles were added in 1.8
+	}
+	if err := p.BufferPacket(&packet.Title{
+		Action:  packet.TimesTitleAction(protocol),
+		FadeIn:  ticks(t.FadeIn),
+		Stay:    ticks(t.Stay),
+		FadeOut: ticks(t.FadeOut),
+	}); err != nil {
+		return err
+	}
+	if t.Subtitle != nil {
+		if err := p.bufferTitleComponent(packet.SetSubtitle, t.Subtitle); err != nil {
+			return err
+		}
+	}
+	title := t.Title
+	if title == nil {
+		title = &component.Text{}
+	}
+	// The title must be sent last, since it makes the client display the title.
+	return p.bufferTitleComponent(packet.SetTitle, title)
+}
+
+// bufferTitleComponent buffers a title packet of the action containing the component.
+func (p *connectedPlayer) bufferTitleComponent(action packet.TitleAction, c component.Component) error {
+	s, err := util.DefaultComponentCache.Get(c, p.Protocol())
+	if err != nil {
+		return err
+	}
+	return p.BufferPacket(&packet.Title{
+		Action:    action,
+		Component: &s,
+	})
+}
+
+// ticks converts the duration to Minecraft ticks (20 ticks per second).
+func ticks(d time.Duration) int {
+	return int(d / (time.Second / 20))
+}
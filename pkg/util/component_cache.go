@@ -0,0 +1,68 @@
+package util
+
+import (
+	"github.com/golang/groupcache/lru"
+	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/component/codec"
+	"go.minekube.com/gate/pkg/proto"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ComponentCache memoizes the json encoding of components
+// for the json codec of a protocol version in a fixed-size LRU cache.
+//
+// Components are keyed by identity, so a component
+// must not be modified after it was passed to the cache.
+type ComponentCache struct {
+	mu    sync.Mutex // Protects cache
+	cache *lru.Cache
+}
+
+// DefaultComponentCache is the cache used for components sent to players.
+var DefaultComponentCache = NewComponentCache(256)
+
+// NewComponentCache returns a new ComponentCache holding at most maxEntries.
+func NewComponentCache(maxEntries int) *ComponentCache {
+	return &ComponentCache{cache: lru.New(maxEntries)}
+}
+
+type componentCacheKey struct {
+	msg   component.Component
+	codec codec.Codec
+}
+
+// Get returns the json encoding of msg for the protocol version.
+func (c *ComponentCache) Get(msg component.Component, protocol proto.Protocol) (string, error) {
+	jsonCodec := JsonCodec(protocol)
+	// Components that are not comparable can't be used as map key.
+	if msg == nil || !reflect.TypeOf(msg).Comparable() {
+		return marshal(jsonCodec, msg)
+	}
+
+	key := componentCacheKey{msg: msg, codec: jsonCodec}
+	c.mu.Lock()
+	v, ok := c.cache.Get(key)
+	c.mu.Unlock()
+	if ok {
+		return v.(string), nil
+	}
+
+	s, err := marshal(jsonCodec, msg)
+	if err != nil {
+		return "", err
+	}
+	c.mu.Lock()
+	c.cache.Add(key, s)
+	c.mu.Unlock()
+	return s, nil
+}
+
+func marshal(jsonCodec codec.Codec, msg component.Component) (string, error) {
+	b := new(strings.Builder)
+	if err := jsonCodec.Marshal(b, msg); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
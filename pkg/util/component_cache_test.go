@@ -0,0 +1,47 @@
+package util
+
+import (
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/gate/pkg/proto"
+	"strings"
+	"testing"
+)
+
+func TestComponentCache(t *testing.T) {
+	c := NewComponentCache(1)
+	msg := &component.Text{Content: "hello"}
+
+	s, err := c.Get(msg, proto.Minecraft_1_16.Protocol)
+	require.NoError(t, err)
+	b := new(strings.Builder)
+	require.NoError(t, JsonCodec(proto.Minecraft_1_16.Protocol).Marshal(b, msg))
+	require.Equal(t, b.String(), s)
+
+	cached, err := c.Get(msg, proto.Minecraft_1_16.Protocol)
+	require.NoError(t, err)
+	require.Equal(t, s, cached)
+	require.Equal(t, 1, c.cache.Len())
+}
+
+// Simulates sending the same broadcast message to 100 players.
+const benchmarkPlayers = 100
+
+func BenchmarkBroadcastMarshal(b *testing.B) {
+	msg := &component.Text{Content: "Welcome to the server!"}
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < benchmarkPlayers; j++ {
+			_, _ = marshal(JsonCodec(proto.Minecraft_1_16.Protocol), msg)
+		}
+	}
+}
+
+func BenchmarkBroadcastComponentCache(b *testing.B) {
+	msg := &component.Text{Content: "Welcome to the server!"}
+	c := NewComponentCache(256)
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < benchmarkPlayers; j++ {
+			_, _ = c.Get(msg, proto.Minecraft_1_16.Protocol)
+		}
+	}
+}